@@ -1,6 +1,7 @@
 package main
 
 import (
+	"embed"
 	_ "image/png"
 	"log"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 )
 
+//go:embed grass.png
+var assets embed.FS
+
 // Game implements ebiten.Game interface.
 type Game struct {
 	engine *katsu2d.Engine
@@ -33,7 +37,10 @@ func NewGame() *Game {
 	world := g.engine.World()
 
 	// --- Texture Loading ---
-	foliageImage, _, _ := ebitenutil.NewImageFromFile("./grass.png")
+	foliageImage, _, err := ebitenutil.NewImageFromFileSystem(assets, "grass.png")
+	if err != nil {
+		log.Fatalf("failed to load texture: %v", err)
+	}
 	foliageTextureID := tm.Add(foliageImage)
 
 	// --- System Setup ---
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"embed"
 	"fmt"
 	"log"
 
@@ -14,6 +15,9 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
+//go:embed wheat.png
+var assets embed.FS
+
 type GrassSystem struct {
 	debugImg *ebiten.Image
 }
@@ -91,7 +95,10 @@ func NewGame() *Game {
 
 	// --- Texture Loading ---
 	// Grass
-	grass, _, _ := ebitenutil.NewImageFromFile("./wheat.png")
+	grass, _, err := ebitenutil.NewImageFromFileSystem(assets, "wheat.png")
+	if err != nil {
+		log.Fatalf("failed to load texture: %v", err)
+	}
 	texId := tm.Add(grass) // ID 1: "grass"
 
 	// grass controller